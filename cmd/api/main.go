@@ -1,14 +1,32 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 
+	"appointment-scheduling-bot/internal/calendar"
+	_ "appointment-scheduling-bot/internal/calendar/caldav"
+	_ "appointment-scheduling-bot/internal/calendar/google"
+	_ "appointment-scheduling-bot/internal/calendar/memory"
+	"appointment-scheduling-bot/internal/calendar/scheduler"
+	"appointment-scheduling-bot/internal/calendar/subscriptions"
 	"appointment-scheduling-bot/internal/shared/config"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 )
 
+// newCalendarClient initializes the configured calendar backend
+func newCalendarClient(cfg config.Config) calendar.Client {
+	client, err := calendar.Open(cfg.CalendarBackend, cfg)
+	if err != nil {
+		log.Printf("Warning: Failed to initialize %q calendar backend: %v", cfg.CalendarBackend, err)
+		return nil
+	}
+	return client
+}
+
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -16,6 +34,22 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	calendarClient := newCalendarClient(cfg)
+	var slotScheduler *scheduler.Scheduler
+	if calendarClient != nil {
+		slotScheduler = scheduler.New(calendarClient)
+	}
+
+	var subStore *subscriptions.Store
+	if calendarClient != nil && cfg.WebhookCallbackURL != "" {
+		var err error
+		subStore, err = subscriptions.NewRedisStore(cfg.RedisURL)
+		if err != nil {
+			log.Printf("Warning: Failed to initialize subscription store: %v", err)
+			subStore = nil
+		}
+	}
+
 	// Create Fiber app
 	app := fiber.New(fiber.Config{
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
@@ -48,6 +82,34 @@ func main() {
 		return c.JSON(envSummary)
 	})
 
+	// Slot availability endpoint
+	app.Post("/slots", func(c *fiber.Ctx) error {
+		if slotScheduler == nil {
+			return fiber.NewError(fiber.StatusServiceUnavailable, "no calendar backend configured")
+		}
+
+		var req scheduler.SlotRequest
+		if err := c.BodyParser(&req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		}
+
+		slots, err := slotScheduler.FindAvailableSlots(c.Context(), req)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		return c.JSON(fiber.Map{"slots": slots})
+	})
+
+	// Webhook-based calendar change subscriptions
+	if subStore != nil {
+		registerGCalWebhook(app, calendarClient, subStore)
+
+		renewerCtx, cancelRenewer := context.WithCancel(context.Background())
+		defer cancelRenewer()
+		startSubscriptionRenewer(renewerCtx, calendarClient, subStore, cfg.WebhookCallbackURL)
+	}
+
 	// Start server
 	port := ":" + cfg.HttpPort
 	log.Printf("Starting server on port %s", port)
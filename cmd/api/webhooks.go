@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/calendar/subscriptions"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// webhookChannelTTL is how long a Google push-notification channel stays
+// alive before it needs renewing.
+const webhookChannelTTL = 24 * time.Hour
+
+// registerGCalWebhook wires up the /webhooks/gcal handler, which receives
+// Google Calendar push notifications, validates them against the stored
+// subscription, and triggers an incremental re-sync.
+func registerGCalWebhook(app *fiber.App, client calendar.Client, store *subscriptions.Store) {
+	app.Post("/webhooks/gcal", func(c *fiber.Ctx) error {
+		channelID := c.Get("X-Goog-Channel-Id")
+		resourceID := c.Get("X-Goog-Resource-Id")
+		resourceState := c.Get("X-Goog-Resource-State")
+		token := c.Get("X-Goog-Channel-Token")
+
+		if channelID == "" {
+			return fiber.NewError(fiber.StatusBadRequest, "missing X-Goog-Channel-Id header")
+		}
+
+		sub, err := store.Get(c.Context(), channelID)
+		if err != nil {
+			return fiber.NewError(fiber.StatusNotFound, "unknown subscription channel")
+		}
+		if sub.Token != token || sub.ResourceID != resourceID {
+			return fiber.NewError(fiber.StatusForbidden, "channel token/resource mismatch")
+		}
+
+		// The initial "sync" notification just confirms the channel is live.
+		if resourceState == "sync" {
+			return c.SendStatus(fiber.StatusOK)
+		}
+
+		result, err := client.IncrementalSync(c.Context(), sub.SyncToken)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("incremental sync failed: %v", err))
+		}
+
+		sub.SyncToken = result.NextSyncToken
+		if err := store.Save(c.Context(), sub); err != nil {
+			log.Printf("Warning: failed to persist updated sync token for channel %s: %v", channelID, err)
+		}
+
+		return c.SendStatus(fiber.StatusOK)
+	})
+}
+
+// startSubscriptionRenewer runs in the background, renewing push-notification
+// channels shortly before they expire so webhook delivery never lapses.
+func startSubscriptionRenewer(ctx context.Context, client calendar.Client, store *subscriptions.Store, callbackURL string) {
+	ticker := time.NewTicker(webhookChannelTTL / 4)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				renewExpiringSubscriptions(ctx, client, store, callbackURL)
+			}
+		}
+	}()
+}
+
+func renewExpiringSubscriptions(ctx context.Context, client calendar.Client, store *subscriptions.Store, callbackURL string) {
+	expiring, err := store.ExpiringBefore(ctx, time.Now().Add(webhookChannelTTL/4))
+	if err != nil {
+		log.Printf("Warning: failed to list expiring subscriptions: %v", err)
+		return
+	}
+
+	for _, sub := range expiring {
+		// Renewal fires at 3/4 of the TTL, so the old channel still has
+		// roughly a quarter of its life left. Reusing its ID in place would
+		// make store.Save overwrite ResourceID before the old channel stops
+		// delivering, and the webhook handler's ResourceID check would then
+		// reject every notification it sends until it finally expires. Mint
+		// a fresh channel and only retire the old one once it's confirmed.
+		newChannelID := uuid.NewString()
+		renewed, err := client.Subscribe(ctx, newChannelID, callbackURL, webhookChannelTTL)
+		if err != nil {
+			log.Printf("Warning: failed to renew channel %s: %v", sub.ChannelID, err)
+			continue
+		}
+		renewed.SyncToken = sub.SyncToken
+		if err := store.Save(ctx, renewed); err != nil {
+			log.Printf("Warning: failed to persist renewed channel %s: %v", newChannelID, err)
+			continue
+		}
+
+		if err := client.Unsubscribe(ctx, sub); err != nil {
+			log.Printf("Warning: failed to unsubscribe old channel %s: %v", sub.ChannelID, err)
+		}
+		if err := store.Delete(ctx, sub.ChannelID); err != nil {
+			log.Printf("Warning: failed to delete old subscription record %s: %v", sub.ChannelID, err)
+		}
+	}
+}
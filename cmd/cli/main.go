@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"appointment-scheduling-bot/internal/calendar"
-	"appointment-scheduling-bot/internal/calendar/google"
+	_ "appointment-scheduling-bot/internal/calendar/caldav"
+	_ "appointment-scheduling-bot/internal/calendar/google"
+	_ "appointment-scheduling-bot/internal/calendar/memory"
+	"appointment-scheduling-bot/internal/calendar/scheduler"
 	"appointment-scheduling-bot/internal/shared/config"
 
 	"github.com/spf13/cobra"
@@ -15,7 +18,7 @@ import (
 
 var (
 	cfg     config.Config
-	client  *google.Client
+	client  calendar.Client
 	rootCmd = &cobra.Command{
 		Use:   "cli",
 		Short: "Appointment Scheduling Bot CLI",
@@ -31,12 +34,12 @@ func init() {
 		log.Printf("Warning: Failed to load config: %v", err)
 	}
 
-	// Initialize Google Calendar client if possible
-	if cfg.GCalCalendarID != "" && cfg.GoogleCredsJSON != "" {
-		client, err = google.NewClient(cfg)
-		if err != nil {
-			log.Printf("Warning: Failed to initialize Google Calendar client: %v", err)
-		}
+	// Initialize the configured calendar backend if possible
+	backendClient, err := calendar.Open(cfg.CalendarBackend, cfg)
+	if err != nil {
+		log.Printf("Warning: Failed to initialize %q calendar backend: %v", cfg.CalendarBackend, err)
+	} else {
+		client = backendClient
 	}
 }
 
@@ -108,6 +111,7 @@ var createEventCmd = &cobra.Command{
 		attendeeEmail, _ := cmd.Flags().GetString("attendee-email")
 		location, _ := cmd.Flags().GetString("location")
 		timezone, _ := cmd.Flags().GetString("timezone")
+		calendarID, _ := cmd.Flags().GetString("calendar-id")
 
 		if summary == "" {
 			fmt.Println("Error: summary is required")
@@ -141,6 +145,7 @@ var createEventCmd = &cobra.Command{
 			AttendeeEmail: attendeeEmail,
 			Location:      location,
 			Timezone:      timezone,
+			CalendarID:    calendarID,
 		}
 
 		eventID, err := client.CreateEvent(appt)
@@ -153,6 +158,102 @@ var createEventCmd = &cobra.Command{
 	},
 }
 
+var slotsCmd = &cobra.Command{
+	Use:   "slots",
+	Short: "List available booking slots",
+	Long:  `Compute available booking slots between the specified date range given working hours.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if client == nil {
+			fmt.Println("Error: calendar client not initialized")
+			os.Exit(1)
+		}
+
+		fromStr, _ := cmd.Flags().GetString("from")
+		toStr, _ := cmd.Flags().GetString("to")
+		duration, _ := cmd.Flags().GetDuration("duration")
+		granularity, _ := cmd.Flags().GetDuration("granularity")
+		workStart, _ := cmd.Flags().GetString("work-start")
+		workEnd, _ := cmd.Flags().GetString("work-end")
+		timezone, _ := cmd.Flags().GetString("timezone")
+		bufferBefore, _ := cmd.Flags().GetDuration("buffer-before")
+		bufferAfter, _ := cmd.Flags().GetDuration("buffer-after")
+		minNotice, _ := cmd.Flags().GetDuration("min-notice")
+		maxPerDay, _ := cmd.Flags().GetInt("max-per-day")
+
+		from, err := time.Parse("2006-01-02", fromStr)
+		if err != nil {
+			fmt.Printf("Error parsing from date: %v\n", err)
+			os.Exit(1)
+		}
+		to, err := time.Parse("2006-01-02", toStr)
+		if err != nil {
+			fmt.Printf("Error parsing to date: %v\n", err)
+			os.Exit(1)
+		}
+
+		window := []scheduler.Window{{Start: workStart, End: workEnd}}
+		req := scheduler.SlotRequest{
+			From:        from,
+			To:          to,
+			Duration:    duration,
+			Granularity: granularity,
+			WorkingHours: map[time.Weekday][]scheduler.Window{
+				time.Monday:    window,
+				time.Tuesday:   window,
+				time.Wednesday: window,
+				time.Thursday:  window,
+				time.Friday:    window,
+			},
+			Timezone:          timezone,
+			BufferBefore:      bufferBefore,
+			BufferAfter:       bufferAfter,
+			MinNotice:         minNotice,
+			MaxBookingsPerDay: maxPerDay,
+		}
+
+		s := scheduler.New(client)
+		slots, err := s.FindAvailableSlots(cmd.Context(), req)
+		if err != nil {
+			fmt.Printf("Error computing available slots: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(slots) == 0 {
+			fmt.Println("No available slots found.")
+			return
+		}
+		for i, slot := range slots {
+			fmt.Printf("%d. %s - %s\n", i+1, slot.Start.Format("2006-01-02 15:04"), slot.End.Format("2006-01-02 15:04"))
+		}
+	},
+}
+
+var listCalendarsCmd = &cobra.Command{
+	Use:   "list-calendars",
+	Short: "List calendars visible to the configured backend",
+	Long:  `List every calendar the configured backend can read from or write to.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if client == nil {
+			fmt.Println("Error: calendar client not initialized")
+			os.Exit(1)
+		}
+
+		calendars, err := client.ListCalendars()
+		if err != nil {
+			fmt.Printf("Error listing calendars: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(calendars) == 0 {
+			fmt.Println("No calendars found.")
+			return
+		}
+		for i, cal := range calendars {
+			fmt.Printf("%d. %s - %s (timezone: %s, access: %s)\n", i+1, cal.ID, cal.Summary, cal.Timezone, cal.AccessRole)
+		}
+	},
+}
+
 func main() {
 	// Add flags to list-busy command
 	listBusyCmd.Flags().String("from", time.Now().Format("2006-01-02"), "Start date (YYYY-MM-DD)")
@@ -167,10 +268,26 @@ func main() {
 	createEventCmd.Flags().String("attendee-email", "", "Attendee email")
 	createEventCmd.Flags().String("location", "", "Event location")
 	createEventCmd.Flags().String("timezone", "UTC", "Event timezone")
+	createEventCmd.Flags().String("calendar-id", "", "Calendar ID to create the event on (defaults to the primary calendar)")
+
+	// Add flags to slots command
+	slotsCmd.Flags().String("from", time.Now().Format("2006-01-02"), "Start date (YYYY-MM-DD)")
+	slotsCmd.Flags().String("to", time.Now().AddDate(0, 0, 7).Format("2006-01-02"), "End date (YYYY-MM-DD)")
+	slotsCmd.Flags().Duration("duration", 30*time.Minute, "Slot duration")
+	slotsCmd.Flags().Duration("granularity", 30*time.Minute, "Slot granularity")
+	slotsCmd.Flags().String("work-start", "09:00", "Working hours start (HH:MM)")
+	slotsCmd.Flags().String("work-end", "17:00", "Working hours end (HH:MM)")
+	slotsCmd.Flags().String("timezone", "UTC", "Timezone for working hours")
+	slotsCmd.Flags().Duration("buffer-before", 0, "Buffer time required before a busy block")
+	slotsCmd.Flags().Duration("buffer-after", 0, "Buffer time required after a busy block")
+	slotsCmd.Flags().Duration("min-notice", 0, "Minimum notice required before a slot")
+	slotsCmd.Flags().Int("max-per-day", 0, "Maximum bookable slots per day (0 = unlimited)")
 
 	// Add commands to root
 	rootCmd.AddCommand(listBusyCmd)
 	rootCmd.AddCommand(createEventCmd)
+	rootCmd.AddCommand(slotsCmd)
+	rootCmd.AddCommand(listCalendarsCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,146 @@
+package caldav
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/calendar/calendartest"
+	"appointment-scheduling-bot/internal/shared/config"
+)
+
+// fakeServer is a minimal in-memory CalDAV server: just enough of the
+// discovery dance (current-user-principal -> calendar-home-set -> calendar
+// collection) plus PUT/GET/DELETE/REPORT on event resources for Client to
+// round-trip against. It lets the conformance suite exercise the caldav
+// backend without a real server.
+type fakeServer struct {
+	mu    sync.Mutex
+	store map[string]string // href -> iCalendar data
+	etags map[string]int
+}
+
+func newFakeServer() *httptest.Server {
+	f := &fakeServer{store: make(map[string]string), etags: make(map[string]int)}
+	return httptest.NewServer(http.HandlerFunc(f.handle))
+}
+
+func (f *fakeServer) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "PROPFIND" && r.URL.Path == "/":
+		fmt.Fprint(w, `<?xml version="1.0"?>
+<D:multistatus xmlns:D="DAV:">
+  <D:response>
+    <D:href>/</D:href>
+    <D:propstat><D:prop><D:current-user-principal><D:href>/principals/me/</D:href></D:current-user-principal></D:prop></D:propstat>
+  </D:response>
+</D:multistatus>`)
+	case r.Method == "PROPFIND" && r.URL.Path == "/principals/me/":
+		fmt.Fprint(w, `<?xml version="1.0"?>
+<D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:response>
+    <D:href>/principals/me/</D:href>
+    <D:propstat><D:prop><C:calendar-home-set><D:href>/calendars/me/</D:href></C:calendar-home-set></D:prop></D:propstat>
+  </D:response>
+</D:multistatus>`)
+	case r.Method == "PROPFIND" && r.URL.Path == "/calendars/me/":
+		fmt.Fprint(w, `<?xml version="1.0"?>
+<D:multistatus xmlns:D="DAV:">
+  <D:response>
+    <D:href>/calendars/me/cal/</D:href>
+    <D:propstat><D:prop><D:resourcetype><D:calendar/></D:resourcetype><D:displayname>Test</D:displayname></D:prop></D:propstat>
+  </D:response>
+</D:multistatus>`)
+	case r.Method == "REPORT" && r.URL.Path == "/calendars/me/cal/":
+		f.handleReport(w)
+	case r.Method == "PUT":
+		f.handlePut(w, r)
+	case r.Method == "GET":
+		f.handleGet(w, r)
+	case r.Method == "DELETE":
+		f.handleDelete(w, r)
+	default:
+		http.Error(w, "unsupported request", http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeServer) handleReport(w http.ResponseWriter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0"?><D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+	for href, data := range f.store {
+		sb.WriteString(fmt.Sprintf(`<D:response><D:href>%s</D:href><D:propstat><D:prop><D:getetag>%q</D:getetag><C:calendar-data>%s</C:calendar-data></D:prop></D:propstat></D:response>`,
+			href, strconv.Itoa(f.etags[href]), escapeXML(data)))
+	}
+	sb.WriteString(`</D:multistatus>`)
+	fmt.Fprint(w, sb.String())
+}
+
+func (f *fakeServer) handlePut(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	f.store[r.URL.Path] = string(body)
+	f.etags[r.URL.Path]++
+	etag := f.etags[r.URL.Path]
+	f.mu.Unlock()
+
+	w.Header().Set("ETag", strconv.Itoa(etag))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (f *fakeServer) handleGet(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	data, ok := f.store[r.URL.Path]
+	etag := f.etags[r.URL.Path]
+	f.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("ETag", strconv.Itoa(etag))
+	fmt.Fprint(w, data)
+}
+
+func (f *fakeServer) handleDelete(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	_, ok := f.store[r.URL.Path]
+	delete(f.store, r.URL.Path)
+	delete(f.etags, r.URL.Path)
+	f.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func escapeXML(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	return strings.ReplaceAll(s, ">", "&gt;")
+}
+
+func TestClient_Conformance(t *testing.T) {
+	calendartest.Run(t, func() calendar.Client {
+		// A fresh fake server per subtest keeps state from one subtest from
+		// leaking into another, matching what calendartest.Run expects.
+		srv := newFakeServer()
+		t.Cleanup(srv.Close)
+		client, err := NewClient(config.Config{CaldavURI: srv.URL})
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+		return client
+	})
+}
@@ -0,0 +1,428 @@
+// Package caldav implements the calendar.Client interface against a generic
+// CalDAV server (Nextcloud, Fastmail, iCloud, Radicale, etc.).
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/shared/config"
+
+	ical "github.com/emersion/go-ical"
+	"github.com/google/uuid"
+)
+
+// Client implements the calendar.Client interface against a CalDAV server
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	password   string
+
+	// discovered during NewClient
+	homeSetURL  string
+	calendarURL string
+
+	// resource tracks href + ETag for each event we know about, keyed by UID
+	mu       sync.Mutex
+	resource map[string]resourceInfo
+}
+
+type resourceInfo struct {
+	href string
+	etag string
+}
+
+// NewClient creates a new CalDAV client, performing the discovery dance
+// (current-user-principal -> calendar-home-set -> calendar collection).
+func NewClient(cfg config.Config) (*Client, error) {
+	if cfg.CaldavURI == "" {
+		return nil, fmt.Errorf("CALDAV_URI is required")
+	}
+
+	c := &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    strings.TrimRight(cfg.CaldavURI, "/"),
+		username:   cfg.CaldavUsername,
+		password:   cfg.CaldavPassword,
+		resource:   make(map[string]resourceInfo),
+	}
+
+	principal, err := c.currentUserPrincipal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover current-user-principal: %w", err)
+	}
+
+	homeSet, err := c.calendarHomeSet(principal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover calendar-home-set: %w", err)
+	}
+
+	calendarURL, err := c.firstCalendar(homeSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enumerate calendars: %w", err)
+	}
+
+	c.homeSetURL = homeSet
+	c.calendarURL = calendarURL
+
+	return c, nil
+}
+
+// ListCalendars enumerates the calendar collections under the discovered
+// calendar-home-set
+func (c *Client) ListCalendars() ([]calendar.CalendarInfo, error) {
+	body := `<?xml version="1.0" encoding="utf-8" ?>
+<D:propfind xmlns:D="DAV:">
+  <D:prop><D:resourcetype/><D:displayname/></D:prop>
+</D:propfind>`
+
+	resp, err := c.request("PROPFIND", c.homeSetURL, []byte(body), map[string]string{"Depth": "1"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendars: %w", err)
+	}
+	defer resp.Body.Close()
+
+	ms, err := parseMultistatus(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse calendar listing: %w", err)
+	}
+
+	var infos []calendar.CalendarInfo
+	for _, r := range ms.Responses {
+		if r.Propstat.Prop.ResourceType.Calendar == nil {
+			continue
+		}
+		infos = append(infos, calendar.CalendarInfo{
+			ID:         c.resolve(r.Href),
+			Summary:    r.Propstat.Prop.DisplayName,
+			AccessRole: "owner",
+		})
+	}
+
+	return infos, nil
+}
+
+// ListBusy returns all busy time blocks between from and to
+func (c *Client) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
+	body := fmt.Sprintf(`<?xml version="1.0" encoding="utf-8" ?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop>
+    <D:getetag/>
+    <C:calendar-data/>
+  </D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT">
+        <C:time-range start="%s" end="%s"/>
+      </C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`, toICALTime(from), toICALTime(to))
+
+	resp, err := c.request("REPORT", c.calendarURL, []byte(body), map[string]string{"Depth": "1"})
+	if err != nil {
+		return nil, fmt.Errorf("calendar-query REPORT failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	ms, err := parseMultistatus(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse calendar-query response: %w", err)
+	}
+
+	var blocks []calendar.TimeBlock
+	for _, r := range ms.Responses {
+		data := r.Propstat.Prop.CalendarData
+		if data == "" {
+			continue
+		}
+
+		dec := ical.NewDecoder(strings.NewReader(data))
+		cal, err := dec.Decode()
+		if err != nil {
+			continue
+		}
+
+		for _, event := range cal.Events() {
+			appt, err := apptFromEvent(event)
+			if err != nil {
+				continue
+			}
+			occurrences := calendar.ExpandOccurrences(appt, from, to)
+			for i := range occurrences {
+				occurrences[i].Source = "caldav"
+			}
+			blocks = append(blocks, occurrences...)
+		}
+	}
+
+	return blocks, nil
+}
+
+// CreateEvent creates a new calendar event and returns the event ID
+func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
+	uid := uuid.NewString()
+	href := c.calendarURL + uid + ".ics"
+
+	body := eventToICAL(uid, appt)
+
+	resp, err := c.request("PUT", href, body, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to PUT calendar event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	c.mu.Lock()
+	c.resource[uid] = resourceInfo{href: href, etag: resp.Header.Get("ETag")}
+	c.mu.Unlock()
+
+	return uid, nil
+}
+
+// UpdateEvent updates an existing calendar event
+func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
+	info, err := c.resourceFor(eventID)
+	if err != nil {
+		return err
+	}
+
+	body := eventToICAL(eventID, appt)
+
+	headers := map[string]string{}
+	if info.etag != "" {
+		headers["If-Match"] = info.etag
+	}
+
+	resp, err := c.request("PUT", info.href, body, headers)
+	if err != nil {
+		return fmt.Errorf("failed to PUT calendar event update: %w", err)
+	}
+	defer resp.Body.Close()
+
+	c.mu.Lock()
+	info.etag = resp.Header.Get("ETag")
+	c.resource[eventID] = info
+	c.mu.Unlock()
+
+	return nil
+}
+
+// DeleteEvent deletes a calendar event
+func (c *Client) DeleteEvent(eventID string) error {
+	info, err := c.resourceFor(eventID)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{}
+	if info.etag != "" {
+		headers["If-Match"] = info.etag
+	}
+
+	resp, err := c.request("DELETE", info.href, nil, headers)
+	if err != nil {
+		return fmt.Errorf("failed to DELETE calendar event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	c.mu.Lock()
+	delete(c.resource, eventID)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// resourceFor returns the href + ETag needed to address eventID. The
+// resource map only lives in process memory, so a restart (or a second
+// CLI/server instance) loses it even though the event is still on the
+// server. The href is deterministic, so on a miss we recompute it and GET
+// the resource to recover its current ETag rather than failing outright.
+func (c *Client) resourceFor(eventID string) (resourceInfo, error) {
+	c.mu.Lock()
+	info, ok := c.resource[eventID]
+	c.mu.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	href := c.calendarURL + eventID + ".ics"
+	resp, err := c.request("GET", href, nil, nil)
+	if err != nil {
+		return resourceInfo{}, fmt.Errorf("unknown event ID %q", eventID)
+	}
+	defer resp.Body.Close()
+
+	info = resourceInfo{href: href, etag: resp.Header.Get("ETag")}
+	c.mu.Lock()
+	c.resource[eventID] = info
+	c.mu.Unlock()
+
+	return info, nil
+}
+
+// request issues an HTTP request against the CalDAV server with basic auth applied
+func (c *Client) request(method, url string, body []byte, headers map[string]string) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	if method == "PUT" {
+		req.Header.Set("Content-Type", "text/calendar; charset=utf-8")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%s %s returned %s: %s", method, url, resp.Status, string(msg))
+	}
+
+	return resp, nil
+}
+
+// currentUserPrincipal issues a PROPFIND for DAV:current-user-principal
+func (c *Client) currentUserPrincipal() (string, error) {
+	body := `<?xml version="1.0" encoding="utf-8" ?>
+<D:propfind xmlns:D="DAV:">
+  <D:prop><D:current-user-principal/></D:prop>
+</D:propfind>`
+
+	resp, err := c.request("PROPFIND", c.baseURL+"/", []byte(body), map[string]string{"Depth": "0"})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	ms, err := parseMultistatus(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range ms.Responses {
+		if r.Propstat.Prop.CurrentUserPrincipal.Href != "" {
+			return c.resolve(r.Propstat.Prop.CurrentUserPrincipal.Href), nil
+		}
+	}
+	return "", fmt.Errorf("current-user-principal not found in PROPFIND response")
+}
+
+// calendarHomeSet issues a PROPFIND for CALDAV:calendar-home-set on the principal URL
+func (c *Client) calendarHomeSet(principalURL string) (string, error) {
+	body := `<?xml version="1.0" encoding="utf-8" ?>
+<D:propfind xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop><C:calendar-home-set/></D:prop>
+</D:propfind>`
+
+	resp, err := c.request("PROPFIND", principalURL, []byte(body), map[string]string{"Depth": "0"})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	ms, err := parseMultistatus(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range ms.Responses {
+		if r.Propstat.Prop.CalendarHomeSet.Href != "" {
+			return c.resolve(r.Propstat.Prop.CalendarHomeSet.Href), nil
+		}
+	}
+	return "", fmt.Errorf("calendar-home-set not found in PROPFIND response")
+}
+
+// firstCalendar enumerates the calendar collections under homeSet and returns the first one
+func (c *Client) firstCalendar(homeSet string) (string, error) {
+	body := `<?xml version="1.0" encoding="utf-8" ?>
+<D:propfind xmlns:D="DAV:">
+  <D:prop><D:resourcetype/><D:displayname/></D:prop>
+</D:propfind>`
+
+	resp, err := c.request("PROPFIND", homeSet, []byte(body), map[string]string{"Depth": "1"})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	ms, err := parseMultistatus(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range ms.Responses {
+		if r.Propstat.Prop.ResourceType.Calendar != nil {
+			return c.resolve(r.Href), nil
+		}
+	}
+	return "", fmt.Errorf("no calendar collection found under %s", homeSet)
+}
+
+// resolve turns a (possibly relative) href from a PROPFIND response into an absolute URL
+func (c *Client) resolve(href string) string {
+	if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+		return href
+	}
+	return c.baseURL + href
+}
+
+func toICALTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// multistatus mirrors the subset of RFC 4918 multistatus responses we care about
+type multistatus struct {
+	XMLName   xml.Name   `xml:"multistatus"`
+	Responses []response `xml:"response"`
+}
+
+type response struct {
+	Href     string   `xml:"href"`
+	Propstat propstat `xml:"propstat"`
+}
+
+type propstat struct {
+	Prop prop `xml:"prop"`
+}
+
+type prop struct {
+	CurrentUserPrincipal hrefElem     `xml:"current-user-principal"`
+	CalendarHomeSet      hrefElem     `xml:"calendar-home-set"`
+	ResourceType         resourceType `xml:"resourcetype"`
+	CalendarData         string       `xml:"calendar-data"`
+	DisplayName          string       `xml:"displayname"`
+}
+
+type hrefElem struct {
+	Href string `xml:"href"`
+}
+
+type resourceType struct {
+	Calendar *struct{} `xml:"calendar"`
+}
+
+func parseMultistatus(r io.Reader) (*multistatus, error) {
+	var ms multistatus
+	if err := xml.NewDecoder(r).Decode(&ms); err != nil {
+		return nil, err
+	}
+	return &ms, nil
+}
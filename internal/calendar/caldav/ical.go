@@ -0,0 +1,116 @@
+package caldav
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+
+	ical "github.com/emersion/go-ical"
+)
+
+// eventTimeRange extracts the DTSTART/DTEND of a VEVENT
+func eventTimeRange(event ical.Event) (start, end time.Time, err error) {
+	startProp := event.Props.Get(ical.PropDateTimeStart)
+	if startProp == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("VEVENT missing DTSTART")
+	}
+	start, err = startProp.DateTime(time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse DTSTART: %w", err)
+	}
+
+	endProp := event.Props.Get(ical.PropDateTimeEnd)
+	if endProp == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("VEVENT missing DTEND")
+	}
+	end, err = endProp.DateTime(time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse DTEND: %w", err)
+	}
+
+	return start, end, nil
+}
+
+// apptFromEvent converts a parsed VEVENT into a calendar.Appointment
+func apptFromEvent(event ical.Event) (calendar.Appointment, error) {
+	start, end, err := eventTimeRange(event)
+	if err != nil {
+		return calendar.Appointment{}, err
+	}
+
+	appt := calendar.Appointment{
+		Start: start,
+		End:   end,
+	}
+
+	if p := event.Props.Get(ical.PropSummary); p != nil {
+		appt.Summary = p.Value
+	}
+	if p := event.Props.Get(ical.PropDescription); p != nil {
+		appt.Description = p.Value
+	}
+	if p := event.Props.Get(ical.PropLocation); p != nil {
+		appt.Location = p.Value
+	}
+
+	if p := event.Props.Get(ical.PropRecurrenceRule); p != nil {
+		appt.Recurrence = &calendar.Recurrence{
+			RRule:   p.Value,
+			ExDates: parseDateProps(event.Props.Values(ical.PropExceptionDates)),
+			RDates:  parseDateProps(event.Props.Values(ical.PropRecurrenceDates)),
+		}
+	}
+
+	return appt, nil
+}
+
+// parseDateProps parses a set of EXDATE/RDATE properties into concrete times
+func parseDateProps(props []ical.Prop) []time.Time {
+	var dates []time.Time
+	for _, p := range props {
+		if t, err := p.DateTime(time.UTC); err == nil {
+			dates = append(dates, t)
+		}
+	}
+	return dates
+}
+
+// eventToICAL renders an Appointment as a VCALENDAR/VEVENT document suitable for PUT
+func eventToICAL(uid string, appt calendar.Appointment) []byte {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText(ical.PropProductID, "-//appointment-scheduling-bot//caldav//EN")
+
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetText(ical.PropSummary, appt.Summary)
+	event.Props.SetText(ical.PropDescription, appt.Description)
+	event.Props.SetText(ical.PropLocation, appt.Location)
+	event.Props.SetDateTime(ical.PropDateTimeStart, appt.Start)
+	event.Props.SetDateTime(ical.PropDateTimeEnd, appt.End)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+
+	if appt.Recurrence != nil && appt.Recurrence.RRule != "" {
+		event.Props.SetText(ical.PropRecurrenceRule, appt.Recurrence.RRule)
+		for _, d := range appt.Recurrence.ExDates {
+			prop := ical.NewProp(ical.PropExceptionDates)
+			prop.SetDateTime(d)
+			event.Props.Add(prop)
+		}
+		for _, d := range appt.Recurrence.RDates {
+			prop := ical.NewProp(ical.PropRecurrenceDates)
+			prop.SetDateTime(d)
+			event.Props.Add(prop)
+		}
+	}
+
+	cal.Children = append(cal.Children, event.Component)
+
+	var buf bytes.Buffer
+	enc := ical.NewEncoder(&buf)
+	_ = enc.Encode(cal)
+
+	return buf.Bytes()
+}
@@ -0,0 +1,16 @@
+package caldav
+
+import (
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/shared/config"
+)
+
+func init() {
+	calendar.Register("caldav", func(cfg config.Config) (calendar.Client, error) {
+		client, err := NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+}
@@ -0,0 +1,26 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+)
+
+// Subscribe is not supported by the CalDAV backend: there is no standardized
+// push-notification mechanism across CalDAV servers (Apple's CalDAV "push"
+// extension and Google's proprietary watch API are not portable here).
+func (c *Client) Subscribe(ctx context.Context, channelID, callbackURL string, ttl time.Duration) (calendar.Subscription, error) {
+	return calendar.Subscription{}, fmt.Errorf("caldav: push subscriptions are not supported, poll ListBusy instead")
+}
+
+// Unsubscribe is not supported by the CalDAV backend
+func (c *Client) Unsubscribe(ctx context.Context, sub calendar.Subscription) error {
+	return fmt.Errorf("caldav: push subscriptions are not supported")
+}
+
+// IncrementalSync is not supported by the CalDAV backend
+func (c *Client) IncrementalSync(ctx context.Context, syncToken string) (calendar.SyncResult, error) {
+	return calendar.SyncResult{}, fmt.Errorf("caldav: incremental sync is not supported, poll ListBusy instead")
+}
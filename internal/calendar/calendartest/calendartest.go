@@ -0,0 +1,142 @@
+// Package calendartest is a conformance suite any calendar.Client
+// implementation can be run against. Backends call Run from their own
+// _test.go file with a constructor for a fresh client.
+package calendartest
+
+import (
+	"testing"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+)
+
+// Run exercises the calendar.Client contract against a freshly constructed
+// client returned by newClient. newClient is called once per subtest so
+// state from one subtest never leaks into another.
+func Run(t *testing.T, newClient func() calendar.Client) {
+	t.Helper()
+
+	t.Run("CreateListUpdateDeleteRoundTrip", func(t *testing.T) {
+		client := newClient()
+
+		from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+		appt := calendar.Appointment{
+			Summary: "Checkup",
+			Start:   time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 4, 1, 9, 30, 0, 0, time.UTC),
+		}
+
+		id, err := client.CreateEvent(appt)
+		if err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+		if id == "" {
+			t.Fatal("CreateEvent returned an empty event ID")
+		}
+
+		blocks, err := client.ListBusy(from, to)
+		if err != nil {
+			t.Fatalf("ListBusy: %v", err)
+		}
+		if !containsBlock(blocks, appt.Start, appt.End) {
+			t.Fatalf("ListBusy %+v does not contain created appointment %+v", blocks, appt)
+		}
+
+		appt.Start = appt.Start.Add(time.Hour)
+		appt.End = appt.End.Add(time.Hour)
+		if err := client.UpdateEvent(id, appt); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+
+		blocks, err = client.ListBusy(from, to)
+		if err != nil {
+			t.Fatalf("ListBusy after update: %v", err)
+		}
+		if !containsBlock(blocks, appt.Start, appt.End) {
+			t.Fatalf("ListBusy %+v does not reflect updated appointment %+v", blocks, appt)
+		}
+
+		if err := client.DeleteEvent(id); err != nil {
+			t.Fatalf("DeleteEvent: %v", err)
+		}
+
+		blocks, err = client.ListBusy(from, to)
+		if err != nil {
+			t.Fatalf("ListBusy after delete: %v", err)
+		}
+		if containsBlock(blocks, appt.Start, appt.End) {
+			t.Fatalf("ListBusy %+v still contains deleted appointment %+v", blocks, appt)
+		}
+	})
+
+	t.Run("PreservesTimezone", func(t *testing.T) {
+		client := newClient()
+
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skipf("tzdata unavailable: %v", err)
+		}
+
+		appt := calendar.Appointment{
+			Summary:  "Follow-up",
+			Start:    time.Date(2026, 4, 1, 9, 0, 0, 0, loc),
+			End:      time.Date(2026, 4, 1, 9, 30, 0, 0, loc),
+			Timezone: "America/New_York",
+		}
+
+		if _, err := client.CreateEvent(appt); err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+
+		blocks, err := client.ListBusy(appt.Start.Add(-time.Hour), appt.End.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("ListBusy: %v", err)
+		}
+		if !containsBlock(blocks, appt.Start, appt.End) {
+			t.Fatalf("ListBusy %+v lost the appointment's instant in time", blocks)
+		}
+	})
+
+	t.Run("ListsOverlappingBusyBlocks", func(t *testing.T) {
+		client := newClient()
+
+		from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+
+		first := calendar.Appointment{
+			Summary: "First",
+			Start:   time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC),
+		}
+		second := calendar.Appointment{
+			Summary: "Second",
+			Start:   time.Date(2026, 4, 1, 9, 30, 0, 0, time.UTC),
+			End:     time.Date(2026, 4, 1, 10, 30, 0, 0, time.UTC),
+		}
+
+		if _, err := client.CreateEvent(first); err != nil {
+			t.Fatalf("CreateEvent(first): %v", err)
+		}
+		if _, err := client.CreateEvent(second); err != nil {
+			t.Fatalf("CreateEvent(second): %v", err)
+		}
+
+		blocks, err := client.ListBusy(from, to)
+		if err != nil {
+			t.Fatalf("ListBusy: %v", err)
+		}
+		if !containsBlock(blocks, first.Start, first.End) || !containsBlock(blocks, second.Start, second.End) {
+			t.Fatalf("ListBusy %+v does not contain both overlapping appointments", blocks)
+		}
+	})
+}
+
+func containsBlock(blocks []calendar.TimeBlock, start, end time.Time) bool {
+	for _, b := range blocks {
+		if b.Start.Equal(start) && b.End.Equal(end) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package google
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"appointment-scheduling-bot/internal/calendar"
@@ -15,8 +16,12 @@ import (
 
 // Client implements the calendar.Client interface for Google Calendar
 type Client struct {
-	service      *gcal.Service
-	calendarID   string
+	service *gcal.Service
+	// calendarIDs is every calendar ListBusy aggregates across
+	calendarIDs []string
+	// primaryCalendarID is used for CreateEvent/UpdateEvent unless an
+	// Appointment.CalendarID override is supplied, and always for DeleteEvent
+	primaryCalendarID string
 }
 
 // NewClient creates a new Google Calendar client
@@ -41,22 +46,51 @@ func NewClient(cfg config.Config) (*Client, error) {
 		return nil, fmt.Errorf("failed to create calendar service: %w", err)
 	}
 
+	calendarIDs := cfg.GCalCalendarIDs
+	if len(calendarIDs) == 0 {
+		calendarIDs = []string{cfg.GCalCalendarID}
+	} else if cfg.GCalCalendarID != "" && !contains(calendarIDs, cfg.GCalCalendarID) {
+		calendarIDs = append([]string{cfg.GCalCalendarID}, calendarIDs...)
+	}
+
 	return &Client{
-		service:    service,
-		calendarID: cfg.GCalCalendarID,
+		service:           service,
+		calendarIDs:       calendarIDs,
+		primaryCalendarID: cfg.GCalCalendarID,
 	}, nil
 }
 
-// ListBusy returns all busy time blocks between from and to
+func contains(ids []string, id string) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// calendarIDFor returns the calendar an Appointment targets: its own
+// CalendarID override if set, otherwise the client's primary calendar.
+func (c *Client) calendarIDFor(appt calendar.Appointment) string {
+	if appt.CalendarID != "" {
+		return appt.CalendarID
+	}
+	return c.primaryCalendarID
+}
+
+// ListBusy returns all busy time blocks between from and to, aggregated
+// across every calendar in c.calendarIDs and tagged with their origin.
 func (c *Client) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
 
-	// Create free/busy query
+	// Create a single free/busy query covering every calendar
+	items := make([]*gcal.FreeBusyRequestItem, len(c.calendarIDs))
+	for i, id := range c.calendarIDs {
+		items[i] = &gcal.FreeBusyRequestItem{Id: id}
+	}
 	query := &gcal.FreeBusyRequest{
 		TimeMin: from.Format(time.RFC3339),
 		TimeMax: to.Format(time.RFC3339),
-		Items: []*gcal.FreeBusyRequestItem{
-			{Id: c.calendarID},
-		},
+		Items:   items,
 	}
 
 	// Execute free/busy query
@@ -67,9 +101,14 @@ func (c *Client) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
 	}
 
 	var timeBlocks []calendar.TimeBlock
-	
-	// Process busy times for the calendar
-	if cal, exists := result.Calendars[c.calendarID]; exists {
+	seen := make(map[string]bool)
+
+	for _, calendarID := range c.calendarIDs {
+		source := calendarSource(calendarID)
+		cal, exists := result.Calendars[calendarID]
+		if !exists {
+			continue
+		}
 		for _, busy := range cal.Busy {
 			start, err := time.Parse(time.RFC3339, busy.Start)
 			if err != nil {
@@ -83,14 +122,252 @@ func (c *Client) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
 			timeBlocks = append(timeBlocks, calendar.TimeBlock{
 				Start:  start,
 				End:    end,
-				Source: "google_calendar",
+				Source: source,
 			})
+			seen[dedupeKey(calendarID, start)] = true
+		}
+
+		// Freebusy already expands recurrence server-side, but it doesn't
+		// expose which instances were cancelled or individually overridden.
+		// Walk the recurring masters ourselves so ExpandOccurrences +
+		// single-instance overrides stay authoritative, and only add what
+		// Freebusy missed.
+		recurring, err := c.listRecurringBusy(calendarID, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand recurring events for %s: %w", calendarID, err)
+		}
+		for _, block := range recurring {
+			key := dedupeKey(calendarID, block.Start)
+			if seen[key] {
+				continue
+			}
+			block.Source = source
+			timeBlocks = append(timeBlocks, block)
+			seen[key] = true
 		}
 	}
 
 	return timeBlocks, nil
 }
 
+// calendarSource renders a TimeBlock.Source tag for a given calendar ID
+func calendarSource(calendarID string) string {
+	return fmt.Sprintf("google_calendar:%s", calendarID)
+}
+
+func dedupeKey(calendarID string, start time.Time) string {
+	return calendarID + "|" + start.UTC().Format(time.RFC3339)
+}
+
+// listRecurringBusy expands recurring events on calendarID into concrete
+// TimeBlocks, applying EXDATEs and single-instance overrides (events with
+// RecurringEventId set) along the way.
+func (c *Client) listRecurringBusy(calendarID string, from, to time.Time) ([]calendar.TimeBlock, error) {
+	// SingleEvents(false) filters by the master event's own original
+	// start/end, not by occurrence time, so TimeMin/TimeMax must be left
+	// unbounded here or any series whose first occurrence predates `from`
+	// (i.e. any ongoing recurring meeting) would be silently excluded.
+	// ExpandOccurrences below does the actual windowing against from/to.
+	var masterEvents []*gcal.Event
+	pageToken := ""
+	for {
+		call := c.service.Events.List(calendarID).SingleEvents(false)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		page, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %w", err)
+		}
+		masterEvents = append(masterEvents, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	// Single-instance overrides (including cancellations) for recurring
+	// events are only surfaced when SingleEvents is true.
+	instances, err := c.service.Events.List(calendarID).
+		TimeMin(from.Format(time.RFC3339)).
+		TimeMax(to.Format(time.RFC3339)).
+		SingleEvents(true).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event instances: %w", err)
+	}
+
+	overridesByMaster := make(map[string][]*gcal.Event)
+	for _, inst := range instances.Items {
+		if inst.RecurringEventId != "" {
+			overridesByMaster[inst.RecurringEventId] = append(overridesByMaster[inst.RecurringEventId], inst)
+		}
+	}
+
+	var blocks []calendar.TimeBlock
+	for _, event := range masterEvents {
+		if len(event.Recurrence) == 0 {
+			continue
+		}
+
+		appt, err := apptFromEvent(event)
+		if err != nil {
+			continue
+		}
+
+		occurrences := calendar.ExpandOccurrences(appt, from, to)
+
+		for _, override := range overridesByMaster[event.Id] {
+			occurrences = applyOverride(occurrences, override)
+		}
+
+		blocks = append(blocks, occurrences...)
+	}
+
+	return blocks, nil
+}
+
+// applyOverride removes the occurrence matching a cancelled override, or
+// replaces its time range when the override moved to a new start/end.
+func applyOverride(occurrences []calendar.TimeBlock, override *gcal.Event) []calendar.TimeBlock {
+	origStart, err := parseEventTime(override.OriginalStartTime)
+	if err != nil {
+		return occurrences
+	}
+
+	var result []calendar.TimeBlock
+	for _, occ := range occurrences {
+		if !occ.Start.Equal(origStart) {
+			result = append(result, occ)
+			continue
+		}
+		if override.Status == "cancelled" {
+			continue // drop the cancelled instance entirely
+		}
+		newStart, errStart := parseEventTime(override.Start)
+		newEnd, errEnd := parseEventTime(override.End)
+		if errStart != nil || errEnd != nil {
+			result = append(result, occ) // keep the original if we can't parse the override
+			continue
+		}
+		result = append(result, calendar.TimeBlock{Start: newStart, End: newEnd})
+	}
+	return result
+}
+
+// parseEventTime parses a Google EventDateTime, whether it carries a DateTime or an all-day Date
+func parseEventTime(dt *gcal.EventDateTime) (time.Time, error) {
+	if dt == nil {
+		return time.Time{}, fmt.Errorf("nil event time")
+	}
+	if dt.DateTime != "" {
+		return time.Parse(time.RFC3339, dt.DateTime)
+	}
+	return time.Parse("2006-01-02", dt.Date)
+}
+
+// apptFromEvent converts a Google recurring master event into a calendar.Appointment
+// with a Recurrence built from its RRULE/EXDATE/RDATE lines.
+func apptFromEvent(event *gcal.Event) (calendar.Appointment, error) {
+	start, err := parseEventTime(event.Start)
+	if err != nil {
+		return calendar.Appointment{}, fmt.Errorf("failed to parse event start: %w", err)
+	}
+	end, err := parseEventTime(event.End)
+	if err != nil {
+		return calendar.Appointment{}, fmt.Errorf("failed to parse event end: %w", err)
+	}
+
+	appt := calendar.Appointment{
+		Summary:     event.Summary,
+		Description: event.Description,
+		Start:       start,
+		End:         end,
+		Location:    event.Location,
+	}
+
+	recurrence := &calendar.Recurrence{}
+	for _, line := range event.Recurrence {
+		name, value := splitRecurrenceLine(line)
+		switch name {
+		case "RRULE":
+			recurrence.RRule = value
+		case "EXDATE":
+			recurrence.ExDates = append(recurrence.ExDates, parseRecurrenceDates(value, start.Location())...)
+		case "RDATE":
+			recurrence.RDates = append(recurrence.RDates, parseRecurrenceDates(value, start.Location())...)
+		}
+	}
+	appt.Recurrence = recurrence
+
+	return appt, nil
+}
+
+// splitRecurrenceLine splits an RFC 5545 content line such as
+// "EXDATE;TZID=America/New_York:20170118T110000" into its property name
+// ("EXDATE") and value, ignoring any parameters between them. Google emits
+// a TZID parameter on EXDATE/RDATE for any non-UTC recurring event as soon
+// as a single occurrence is cancelled through the Calendar UI, so matching
+// on a literal "EXDATE:" prefix misses those lines entirely.
+func splitRecurrenceLine(line string) (name, value string) {
+	colon := strings.IndexByte(line, ':')
+	if colon < 0 {
+		return line, ""
+	}
+	nameAndParams, value := line[:colon], line[colon+1:]
+	if semi := strings.IndexByte(nameAndParams, ';'); semi >= 0 {
+		nameAndParams = nameAndParams[:semi]
+	}
+	return nameAndParams, value
+}
+
+// parseRecurrenceDates parses a comma-separated EXDATE/RDATE value, tolerating
+// both UTC ("...Z") and local ("...") RFC 5545 date-time forms. Local forms are
+// read in loc, which callers pass as the master event's start zone; a TZID
+// parameter on the line is not itself consulted.
+func parseRecurrenceDates(value string, loc *time.Location) []time.Time {
+	var dates []time.Time
+	for _, raw := range strings.Split(value, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		if t, err := time.Parse("20060102T150405Z", raw); err == nil {
+			dates = append(dates, t)
+			continue
+		}
+		if t, err := time.ParseInLocation("20060102T150405", raw, loc); err == nil {
+			dates = append(dates, t)
+		}
+	}
+	return dates
+}
+
+// recurrenceLines renders a calendar.Recurrence back into RFC 5545 lines
+// suitable for gcal.Event.Recurrence.
+func recurrenceLines(r *calendar.Recurrence) []string {
+	if r == nil || r.RRule == "" {
+		return nil
+	}
+
+	lines := []string{"RRULE:" + r.RRule}
+	if len(r.ExDates) > 0 {
+		lines = append(lines, "EXDATE:"+formatRecurrenceDates(r.ExDates))
+	}
+	if len(r.RDates) > 0 {
+		lines = append(lines, "RDATE:"+formatRecurrenceDates(r.RDates))
+	}
+	return lines
+}
+
+func formatRecurrenceDates(dates []time.Time) string {
+	formatted := make([]string, len(dates))
+	for i, d := range dates {
+		formatted[i] = d.UTC().Format("20060102T150405Z")
+	}
+	return strings.Join(formatted, ",")
+}
+
 // CreateEvent creates a new calendar event and returns the event ID
 func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
 
@@ -106,7 +383,8 @@ func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
 			DateTime: appt.End.Format(time.RFC3339),
 			TimeZone: appt.Timezone,
 		},
-		Location: appt.Location,
+		Location:    appt.Location,
+		Recurrence:  recurrenceLines(appt.Recurrence),
 	}
 
 	// Add attendee if provided
@@ -119,7 +397,7 @@ func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
 	}
 
 	// Insert the event
-	call := c.service.Events.Insert(c.calendarID, event)
+	call := c.service.Events.Insert(c.calendarIDFor(appt), event)
 	createdEvent, err := call.Do()
 	if err != nil {
 		return "", fmt.Errorf("failed to create calendar event: %w", err)
@@ -132,7 +410,7 @@ func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
 func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
 
 	// First, get the existing event
-	getCall := c.service.Events.Get(c.calendarID, eventID)
+	getCall := c.service.Events.Get(c.calendarIDFor(appt), eventID)
 	existingEvent, err := getCall.Do()
 	if err != nil {
 		return fmt.Errorf("failed to get existing event: %w", err)
@@ -150,6 +428,7 @@ func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
 		TimeZone: appt.Timezone,
 	}
 	existingEvent.Location = appt.Location
+	existingEvent.Recurrence = recurrenceLines(appt.Recurrence)
 
 	// Update attendees if provided
 	if appt.AttendeeEmail != "" {
@@ -161,7 +440,7 @@ func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
 	}
 
 	// Update the event
-	updateCall := c.service.Events.Update(c.calendarID, eventID, existingEvent)
+	updateCall := c.service.Events.Update(c.calendarIDFor(appt), eventID, existingEvent)
 	_, err = updateCall.Do()
 	if err != nil {
 		return fmt.Errorf("failed to update calendar event: %w", err)
@@ -173,11 +452,31 @@ func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
 // DeleteEvent deletes a calendar event
 func (c *Client) DeleteEvent(eventID string) error {
 
-	call := c.service.Events.Delete(c.calendarID, eventID)
+	call := c.service.Events.Delete(c.primaryCalendarID, eventID)
 	err := call.Do()
 	if err != nil {
 		return fmt.Errorf("failed to delete calendar event: %w", err)
 	}
 
 	return nil
+}
+
+// ListCalendars returns every calendar visible to the service account
+func (c *Client) ListCalendars() ([]calendar.CalendarInfo, error) {
+	result, err := c.service.CalendarList.List().Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendars: %w", err)
+	}
+
+	infos := make([]calendar.CalendarInfo, 0, len(result.Items))
+	for _, item := range result.Items {
+		infos = append(infos, calendar.CalendarInfo{
+			ID:         item.Id,
+			Summary:    item.Summary,
+			Timezone:   item.TimeZone,
+			AccessRole: item.AccessRole,
+		})
+	}
+
+	return infos, nil
 } 
\ No newline at end of file
@@ -0,0 +1,37 @@
+package google
+
+import (
+	"testing"
+	"time"
+
+	gcal "google.golang.org/api/calendar/v3"
+)
+
+func TestApptFromEvent_TZIDExdate(t *testing.T) {
+	event := &gcal.Event{
+		Start: &gcal.EventDateTime{DateTime: "2017-01-04T11:00:00-05:00"},
+		End:   &gcal.EventDateTime{DateTime: "2017-01-04T11:30:00-05:00"},
+		Recurrence: []string{
+			"RRULE:FREQ=WEEKLY;BYDAY=WE",
+			"EXDATE;TZID=America/New_York:20170118T110000",
+		},
+	}
+
+	appt, err := apptFromEvent(event)
+	if err != nil {
+		t.Fatalf("apptFromEvent: %v", err)
+	}
+	if appt.Recurrence.RRule != "FREQ=WEEKLY;BYDAY=WE" {
+		t.Fatalf("expected RRULE to survive the TZID-qualified EXDATE line, got %q", appt.Recurrence.RRule)
+	}
+	if len(appt.Recurrence.ExDates) != 1 {
+		t.Fatalf("expected the TZID-qualified EXDATE to be parsed, got %d exdates", len(appt.Recurrence.ExDates))
+	}
+
+	// 11:00 in the series' -05:00 zone is 16:00 UTC, the instant of the
+	// Jan 18 occurrence the EXDATE cancels.
+	want := time.Date(2017, 1, 18, 16, 0, 0, 0, time.UTC)
+	if !appt.Recurrence.ExDates[0].Equal(want) {
+		t.Fatalf("expected exdate %v, got %v", want, appt.Recurrence.ExDates[0])
+	}
+}
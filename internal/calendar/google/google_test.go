@@ -0,0 +1,179 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/calendar/calendartest"
+
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+// fakeCalendarAPI is a minimal in-memory stand-in for the Calendar v3 REST
+// API: just enough of events.insert/get/update/delete/list and
+// freebusy.query for Client to round-trip against, so the conformance suite
+// can exercise the google backend without real Google credentials.
+type fakeCalendarAPI struct {
+	mu     sync.Mutex
+	nextID int
+	events map[string]*gcal.Event // eventID -> event
+}
+
+func newFakeCalendarAPI() *httptest.Server {
+	f := &fakeCalendarAPI{events: make(map[string]*gcal.Event)}
+	return httptest.NewServer(http.HandlerFunc(f.handle))
+}
+
+func (f *fakeCalendarAPI) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/freeBusy"):
+		f.handleFreeBusy(w, r)
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/events"):
+		f.handleList(w)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/events"):
+		f.handleInsert(w, r)
+	case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/events/"):
+		f.handleGet(w, r)
+	case r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/events/"):
+		f.handleUpdate(w, r)
+	case r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/events/"):
+		f.handleDelete(w, r)
+	default:
+		http.Error(w, "unsupported request", http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeCalendarAPI) handleInsert(w http.ResponseWriter, r *http.Request) {
+	var event gcal.Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	f.nextID++
+	event.Id = "event-" + strconv.Itoa(f.nextID)
+	f.events[event.Id] = &event
+	f.mu.Unlock()
+
+	json.NewEncoder(w).Encode(event)
+}
+
+func (f *fakeCalendarAPI) handleGet(w http.ResponseWriter, r *http.Request) {
+	id := eventIDFromPath(r.URL.Path)
+
+	f.mu.Lock()
+	event, ok := f.events[id]
+	f.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(event)
+}
+
+func (f *fakeCalendarAPI) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	id := eventIDFromPath(r.URL.Path)
+
+	var event gcal.Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event.Id = id
+
+	f.mu.Lock()
+	f.events[id] = &event
+	f.mu.Unlock()
+
+	json.NewEncoder(w).Encode(event)
+}
+
+func (f *fakeCalendarAPI) handleDelete(w http.ResponseWriter, r *http.Request) {
+	id := eventIDFromPath(r.URL.Path)
+
+	f.mu.Lock()
+	delete(f.events, id)
+	f.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeCalendarAPI) handleList(w http.ResponseWriter) {
+	f.mu.Lock()
+	items := make([]*gcal.Event, 0, len(f.events))
+	for _, event := range f.events {
+		items = append(items, event)
+	}
+	f.mu.Unlock()
+
+	json.NewEncoder(w).Encode(gcal.Events{Items: items})
+}
+
+func (f *fakeCalendarAPI) handleFreeBusy(w http.ResponseWriter, r *http.Request) {
+	var req gcal.FreeBusyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	var busy []*gcal.TimePeriod
+	for _, event := range f.events {
+		if event.Start == nil || event.End == nil || event.Start.DateTime == "" {
+			continue
+		}
+		busy = append(busy, &gcal.TimePeriod{Start: event.Start.DateTime, End: event.End.DateTime})
+	}
+	f.mu.Unlock()
+
+	resp := gcal.FreeBusyResponse{Calendars: make(map[string]gcal.FreeBusyCalendar)}
+	for _, item := range req.Items {
+		resp.Calendars[item.Id] = gcal.FreeBusyCalendar{Busy: busy}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func eventIDFromPath(path string) string {
+	parts := strings.Split(path, "/events/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return strings.TrimSuffix(parts[1], "/watch")
+}
+
+func newTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+
+	service, err := gcal.NewService(context.Background(),
+		option.WithEndpoint(endpoint),
+		option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("gcal.NewService: %v", err)
+	}
+
+	return &Client{
+		service:           service,
+		calendarIDs:       []string{"primary"},
+		primaryCalendarID: "primary",
+	}
+}
+
+func TestClient_Conformance(t *testing.T) {
+	calendartest.Run(t, func() calendar.Client {
+		// A fresh fake server per subtest keeps state from one subtest from
+		// leaking into another, matching what calendartest.Run expects.
+		srv := newFakeCalendarAPI()
+		t.Cleanup(srv.Close)
+		return newTestClient(t, srv.URL)
+	})
+}
@@ -0,0 +1,86 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+
+	"github.com/google/uuid"
+	gcal "google.golang.org/api/calendar/v3"
+)
+
+// Subscribe registers a push-notification channel via the Events "watch" API
+func (c *Client) Subscribe(ctx context.Context, channelID, callbackURL string, ttl time.Duration) (calendar.Subscription, error) {
+	token := uuid.NewString()
+	expiration := time.Now().Add(ttl)
+
+	channel := &gcal.Channel{
+		Id:         channelID,
+		Type:       "web_hook",
+		Address:    callbackURL,
+		Token:      token,
+		Expiration: expiration.UnixMilli(),
+	}
+
+	result, err := c.service.Events.Watch(c.primaryCalendarID, channel).Context(ctx).Do()
+	if err != nil {
+		return calendar.Subscription{}, fmt.Errorf("failed to create push channel: %w", err)
+	}
+
+	return calendar.Subscription{
+		ChannelID:  result.Id,
+		ResourceID: result.ResourceId,
+		Token:      token,
+		Expiration: time.UnixMilli(result.Expiration),
+	}, nil
+}
+
+// Unsubscribe tears down a previously registered push-notification channel
+func (c *Client) Unsubscribe(ctx context.Context, sub calendar.Subscription) error {
+	channel := &gcal.Channel{
+		Id:         sub.ChannelID,
+		ResourceId: sub.ResourceID,
+	}
+
+	if err := c.service.Channels.Stop(channel).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to stop push channel: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementalSync returns what changed on the calendar since syncToken was issued.
+// Passing an empty syncToken performs an initial sync and returns a token to
+// resume from. A stale/expired syncToken surfaces as an error from the
+// underlying API (HTTP 410 Gone); callers should retry with an empty token.
+func (c *Client) IncrementalSync(ctx context.Context, syncToken string) (calendar.SyncResult, error) {
+	call := c.service.Events.List(c.primaryCalendarID).Context(ctx).SingleEvents(true)
+	if syncToken != "" {
+		call = call.SyncToken(syncToken)
+	}
+
+	result, err := call.Do()
+	if err != nil {
+		return calendar.SyncResult{}, fmt.Errorf("failed to perform incremental sync: %w", err)
+	}
+
+	var changed []calendar.TimeBlock
+	for _, event := range result.Items {
+		if event.Status == "cancelled" {
+			continue
+		}
+		start, err := parseEventTime(event.Start)
+		if err != nil {
+			continue
+		}
+		end, err := parseEventTime(event.End)
+		if err != nil {
+			continue
+		}
+		changed = append(changed, calendar.TimeBlock{Start: start, End: end, Source: "google_calendar"})
+	}
+
+	return calendar.SyncResult{Changed: changed, NextSyncToken: result.NextSyncToken}, nil
+}
@@ -0,0 +1,152 @@
+// Package memory implements the calendar.Client interface against an
+// in-process map. It has no external dependencies, so it's the backend used
+// by the calendar conformance suite and is handy for local development
+// without a real Google/CalDAV account.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/shared/config"
+
+	"github.com/google/uuid"
+)
+
+// calendarID is the synthetic calendar every memory.Client serves
+const calendarID = "memory"
+
+// Client implements the calendar.Client interface against an in-memory store
+type Client struct {
+	mu     sync.Mutex
+	events map[string]calendar.Appointment
+}
+
+// seedEntry is the shape of an entry in a MEMORY_CALENDAR_SEED file. EventID
+// is optional; one is generated if omitted.
+type seedEntry struct {
+	EventID     string               `json:"event_id,omitempty"`
+	Appointment calendar.Appointment `json:"appointment"`
+}
+
+// NewClient creates a new in-memory calendar client, optionally seeded from
+// the JSON file at cfg.MemoryCalendarSeed.
+func NewClient(cfg config.Config) (*Client, error) {
+	c := &Client{events: make(map[string]calendar.Appointment)}
+
+	if cfg.MemoryCalendarSeed == "" {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(cfg.MemoryCalendarSeed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read memory calendar seed: %w", err)
+	}
+
+	var entries []seedEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse memory calendar seed: %w", err)
+	}
+
+	for _, entry := range entries {
+		id := entry.EventID
+		if id == "" {
+			id = uuid.NewString()
+		}
+		c.events[id] = entry.Appointment
+	}
+
+	return c, nil
+}
+
+// ListBusy returns all busy time blocks between from and to, expanding any
+// recurring appointments via calendar.ExpandOccurrences.
+func (c *Client) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var blocks []calendar.TimeBlock
+	for _, appt := range c.events {
+		for _, block := range calendar.ExpandOccurrences(appt, from, to) {
+			block.Source = "memory"
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+// CreateEvent creates a new calendar event and returns the event ID
+func (c *Client) CreateEvent(appt calendar.Appointment) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := uuid.NewString()
+	c.events[id] = appt
+	return id, nil
+}
+
+// UpdateEvent updates an existing calendar event
+func (c *Client) UpdateEvent(eventID string, appt calendar.Appointment) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.events[eventID]; !ok {
+		return fmt.Errorf("memory: event %q not found", eventID)
+	}
+	c.events[eventID] = appt
+	return nil
+}
+
+// DeleteEvent deletes a calendar event
+func (c *Client) DeleteEvent(eventID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.events[eventID]; !ok {
+		return fmt.Errorf("memory: event %q not found", eventID)
+	}
+	delete(c.events, eventID)
+	return nil
+}
+
+// ListCalendars returns the single synthetic calendar this backend serves
+func (c *Client) ListCalendars() ([]calendar.CalendarInfo, error) {
+	return []calendar.CalendarInfo{
+		{ID: calendarID, Summary: "In-Memory Calendar", Timezone: "UTC", AccessRole: "owner"},
+	}, nil
+}
+
+// Subscribe is a fake push subscription: it never fires, since nothing external
+// can reach an in-process map. It exists so the memory backend satisfies
+// calendar.Client for tests exercising the webhook plumbing around Subscribe.
+func (c *Client) Subscribe(ctx context.Context, channelID, callbackURL string, ttl time.Duration) (calendar.Subscription, error) {
+	return calendar.Subscription{
+		ChannelID:  channelID,
+		ResourceID: calendarID,
+		Token:      uuid.NewString(),
+		Expiration: time.Now().Add(ttl),
+	}, nil
+}
+
+// Unsubscribe is a no-op: there is no real channel to tear down
+func (c *Client) Unsubscribe(ctx context.Context, sub calendar.Subscription) error {
+	return nil
+}
+
+// IncrementalSync always performs a full sync: the in-memory backend doesn't
+// track change history, so it returns every current event with a fresh token.
+func (c *Client) IncrementalSync(ctx context.Context, syncToken string) (calendar.SyncResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var changed []calendar.TimeBlock
+	for _, appt := range c.events {
+		changed = append(changed, calendar.TimeBlock{Start: appt.Start, End: appt.End, Source: "memory"})
+	}
+	return calendar.SyncResult{Changed: changed, NextSyncToken: uuid.NewString()}, nil
+}
@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"testing"
+
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/calendar/calendartest"
+	"appointment-scheduling-bot/internal/shared/config"
+)
+
+func TestClient_Conformance(t *testing.T) {
+	calendartest.Run(t, func() calendar.Client {
+		client, err := NewClient(config.Config{})
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+		return client
+	})
+}
@@ -0,0 +1,16 @@
+package memory
+
+import (
+	"appointment-scheduling-bot/internal/calendar"
+	"appointment-scheduling-bot/internal/shared/config"
+)
+
+func init() {
+	calendar.Register("memory", func(cfg config.Config) (calendar.Client, error) {
+		client, err := NewClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+}
@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// ExpandOccurrences materializes an Appointment's recurrence (if any) into concrete
+// TimeBlocks that fall within [from, to]. Non-recurring appointments that overlap
+// the window yield a single TimeBlock. Shared by every calendar backend so
+// recurrence handling stays consistent regardless of how busy data is sourced.
+func ExpandOccurrences(appt Appointment, from, to time.Time) []TimeBlock {
+	duration := appt.End.Sub(appt.Start)
+
+	if appt.Recurrence == nil || appt.Recurrence.RRule == "" {
+		if appt.Start.Before(to) && appt.End.After(from) {
+			return []TimeBlock{{Start: appt.Start, End: appt.End}}
+		}
+		return nil
+	}
+
+	rule, err := rrule.StrToROptionInLocation(appt.Recurrence.RRule, appt.Start.Location())
+	if err != nil {
+		return nil
+	}
+	rule.Dtstart = appt.Start
+
+	set, err := rrule.NewRRule(*rule)
+	if err != nil {
+		return nil
+	}
+
+	exdates := make(map[int64]bool, len(appt.Recurrence.ExDates))
+	for _, d := range appt.Recurrence.ExDates {
+		exdates[d.UTC().Unix()] = true
+	}
+
+	var blocks []TimeBlock
+
+	// Widen the window by the appointment duration so occurrences that start
+	// before `from` but are still ongoing at `from` are not missed.
+	for _, occStart := range set.Between(from.Add(-duration), to, true) {
+		if exdates[occStart.UTC().Unix()] {
+			continue
+		}
+		occEnd := occStart.Add(duration)
+		if occEnd.Before(from) || occStart.After(to) {
+			continue
+		}
+		blocks = append(blocks, TimeBlock{Start: occStart, End: occEnd})
+	}
+
+	for _, rd := range appt.Recurrence.RDates {
+		rdEnd := rd.Add(duration)
+		if rdEnd.Before(from) || rd.After(to) {
+			continue
+		}
+		blocks = append(blocks, TimeBlock{Start: rd, End: rdEnd})
+	}
+
+	return blocks
+}
@@ -0,0 +1,124 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("tzdata for %s not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestExpandOccurrences_NonRecurring(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	appt := Appointment{Start: start, End: start.Add(time.Hour)}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	blocks := ExpandOccurrences(appt, from, to)
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 block, got %d", len(blocks))
+	}
+	if !blocks[0].Start.Equal(start) {
+		t.Errorf("expected start %v, got %v", start, blocks[0].Start)
+	}
+}
+
+func TestExpandOccurrences_WeeklyAcrossDSTTransition(t *testing.T) {
+	// US DST spring-forward happens 2026-03-08. A weekly 9am local meeting
+	// should still land on 9am local (i.e. the UTC offset shifts) on both
+	// sides of the transition.
+	loc := mustLoadLocation(t, "America/New_York")
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, loc)
+	appt := Appointment{
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Recurrence: &Recurrence{RRule: "FREQ=WEEKLY;COUNT=3"},
+	}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 3, 22, 0, 0, 0, 0, loc)
+
+	blocks := ExpandOccurrences(appt, from, to)
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 occurrences, got %d", len(blocks))
+	}
+	for _, b := range blocks {
+		if hour := b.Start.In(loc).Hour(); hour != 9 {
+			t.Errorf("expected occurrence at 9am local, got %dh (%v)", hour, b.Start)
+		}
+	}
+}
+
+func TestExpandOccurrences_UntilUTC(t *testing.T) {
+	start := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	appt := Appointment{
+		Start:      start,
+		End:        start.Add(30 * time.Minute),
+		Recurrence: &Recurrence{RRule: "FREQ=DAILY;UNTIL=20260107T100000Z"},
+	}
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	blocks := ExpandOccurrences(appt, from, to)
+	if len(blocks) != 3 {
+		t.Fatalf("expected occurrences on Jan 5, 6 and 7, got %d", len(blocks))
+	}
+}
+
+func TestExpandOccurrences_UntilFloatingLocal(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	start := time.Date(2026, 1, 1, 21, 0, 0, 0, loc)
+	appt := Appointment{
+		Start:      start,
+		End:        start.Add(30 * time.Minute),
+		Recurrence: &Recurrence{RRule: "FREQ=DAILY;UNTIL=20260103T215959"},
+	}
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, loc)
+
+	blocks := ExpandOccurrences(appt, from, to)
+	if len(blocks) != 3 {
+		t.Fatalf("expected occurrences on Jan 1, 2 and 3, got %d", len(blocks))
+	}
+}
+
+func TestExpandOccurrences_ExDateExcludesCancelledInstance(t *testing.T) {
+	start := time.Date(2026, 4, 1, 14, 0, 0, 0, time.UTC)
+	cancelled := time.Date(2026, 4, 8, 14, 0, 0, 0, time.UTC)
+
+	appt := Appointment{
+		Start: start,
+		End:   start.Add(time.Hour),
+		Recurrence: &Recurrence{
+			RRule:   "FREQ=WEEKLY;COUNT=4",
+			ExDates: []time.Time{cancelled},
+		},
+	}
+
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	blocks := ExpandOccurrences(appt, from, to)
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 occurrences after excluding the cancelled one, got %d", len(blocks))
+	}
+	for _, b := range blocks {
+		if b.Start.Equal(cancelled) {
+			t.Errorf("cancelled instance %v should have been excluded", cancelled)
+		}
+	}
+}
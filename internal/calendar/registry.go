@@ -0,0 +1,43 @@
+package calendar
+
+import (
+	"fmt"
+	"sync"
+
+	"appointment-scheduling-bot/internal/shared/config"
+)
+
+// Factory builds a Client from configuration. Backends call Register with a
+// Factory in an init() so they're available to Open without cmd/* needing to
+// call each backend's constructor directly; cmd/* only blank-imports the
+// backend packages so their init() runs.
+type Factory func(cfg config.Config) (Client, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register makes a calendar backend available under name. It panics on a
+// duplicate registration, mirroring the stdlib's database/sql.Register.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("calendar: backend %q already registered", name))
+	}
+	registry[name] = factory
+}
+
+// Open constructs the named backend's Client from cfg
+func Open(name string, cfg config.Config) (Client, error) {
+	registryMu.RLock()
+	factory, ok := registry[name]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("calendar: unknown backend %q", name)
+	}
+	return factory(cfg)
+}
@@ -0,0 +1,177 @@
+// Package scheduler computes available booking slots from a calendar.Client's
+// busy blocks, working hours, and booking constraints. It is the layer that
+// turns a raw free/busy wrapper into an actual "curlable" scheduling bot.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+)
+
+// Window is a working-hours window within a single day, in "HH:MM" 24h form
+type Window struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// SlotRequest describes the constraints used to compute available booking slots
+type SlotRequest struct {
+	From        time.Time                `json:"from"`
+	To          time.Time                `json:"to"`
+	Duration    time.Duration             `json:"duration"`
+	Granularity time.Duration             `json:"granularity"`
+	WorkingHours map[time.Weekday][]Window `json:"working_hours"`
+	Timezone    string                    `json:"timezone"`
+	BufferBefore time.Duration            `json:"buffer_before"`
+	BufferAfter  time.Duration            `json:"buffer_after"`
+	MinNotice    time.Duration            `json:"min_notice"`
+	MaxBookingsPerDay int                 `json:"max_bookings_per_day"`
+}
+
+// Slot is a single candidate booking slot
+type Slot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Scheduler computes available slots on top of a calendar.Client
+type Scheduler struct {
+	client calendar.Client
+	now    func() time.Time
+}
+
+// New creates a Scheduler backed by the given calendar client
+func New(client calendar.Client) *Scheduler {
+	return &Scheduler{client: client, now: time.Now}
+}
+
+// FindAvailableSlots returns candidate booking slots within req.From/req.To that
+// don't overlap busy time (plus buffers), fall within working hours, respect
+// MinNotice, and cap at MaxBookingsPerDay slots per day.
+func (s *Scheduler) FindAvailableSlots(ctx context.Context, req SlotRequest) ([]Slot, error) {
+	if req.Duration <= 0 {
+		return nil, fmt.Errorf("duration must be positive")
+	}
+	if req.Granularity <= 0 {
+		return nil, fmt.Errorf("granularity must be positive")
+	}
+
+	loc := time.UTC
+	if req.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(req.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", req.Timezone, err)
+		}
+	}
+
+	busy, err := s.client.ListBusy(req.From, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list busy blocks: %w", err)
+	}
+	merged := mergeBusy(busy, req.BufferBefore, req.BufferAfter)
+
+	earliest := s.now().Add(req.MinNotice)
+
+	var slots []Slot
+	dayStart := req.From.In(loc)
+	for d := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day(), 0, 0, 0, 0, loc); d.Before(req.To); d = d.AddDate(0, 0, 1) {
+		windows := req.WorkingHours[d.Weekday()]
+		daySlots := 0
+
+		for _, w := range windows {
+			winStart, err := combineDayAndClock(d, w.Start, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid working hours start %q: %w", w.Start, err)
+			}
+			winEnd, err := combineDayAndClock(d, w.End, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid working hours end %q: %w", w.End, err)
+			}
+			if !winEnd.After(winStart) {
+				continue
+			}
+			if winStart.Before(req.From) {
+				winStart = req.From
+			}
+			if winEnd.After(req.To) {
+				winEnd = req.To
+			}
+
+			for slotStart := winStart; !slotStart.Add(req.Duration).After(winEnd); slotStart = slotStart.Add(req.Granularity) {
+				slotEnd := slotStart.Add(req.Duration)
+
+				if req.MaxBookingsPerDay > 0 && daySlots >= req.MaxBookingsPerDay {
+					break
+				}
+				if slotStart.Before(earliest) {
+					continue
+				}
+				if overlapsAny(slotStart, slotEnd, merged) {
+					continue
+				}
+
+				slots = append(slots, Slot{Start: slotStart, End: slotEnd})
+				daySlots++
+			}
+		}
+	}
+
+	return slots, nil
+}
+
+// mergeBusy sorts and merges overlapping/adjacent busy blocks, expanding each
+// by the requested buffers first.
+func mergeBusy(blocks []calendar.TimeBlock, bufferBefore, bufferAfter time.Duration) []calendar.TimeBlock {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	buffered := make([]calendar.TimeBlock, len(blocks))
+	for i, b := range blocks {
+		buffered[i] = calendar.TimeBlock{
+			Start: b.Start.Add(-bufferBefore),
+			End:   b.End.Add(bufferAfter),
+		}
+	}
+
+	sort.Slice(buffered, func(i, j int) bool {
+		return buffered[i].Start.Before(buffered[j].Start)
+	})
+
+	merged := []calendar.TimeBlock{buffered[0]}
+	for _, b := range buffered[1:] {
+		last := &merged[len(merged)-1]
+		if !b.Start.After(last.End) {
+			if b.End.After(last.End) {
+				last.End = b.End
+			}
+			continue
+		}
+		merged = append(merged, b)
+	}
+
+	return merged
+}
+
+func overlapsAny(start, end time.Time, blocks []calendar.TimeBlock) bool {
+	for _, b := range blocks {
+		if start.Before(b.End) && end.After(b.Start) {
+			return true
+		}
+	}
+	return false
+}
+
+// combineDayAndClock combines a calendar day with an "HH:MM" clock time in loc
+func combineDayAndClock(day time.Time, clock string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation("15:04", clock, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, loc), nil
+}
@@ -0,0 +1,132 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+)
+
+// fakeClient is a minimal calendar.Client stub returning a fixed set of busy blocks
+type fakeClient struct {
+	busy []calendar.TimeBlock
+}
+
+func (f *fakeClient) ListBusy(from, to time.Time) ([]calendar.TimeBlock, error) {
+	return f.busy, nil
+}
+func (f *fakeClient) CreateEvent(appt calendar.Appointment) (string, error) { return "", nil }
+func (f *fakeClient) UpdateEvent(eventID string, appt calendar.Appointment) error { return nil }
+func (f *fakeClient) DeleteEvent(eventID string) error { return nil }
+func (f *fakeClient) ListCalendars() ([]calendar.CalendarInfo, error) { return nil, nil }
+func (f *fakeClient) Subscribe(ctx context.Context, channelID, callbackURL string, ttl time.Duration) (calendar.Subscription, error) {
+	return calendar.Subscription{}, nil
+}
+func (f *fakeClient) Unsubscribe(ctx context.Context, sub calendar.Subscription) error { return nil }
+func (f *fakeClient) IncrementalSync(ctx context.Context, syncToken string) (calendar.SyncResult, error) {
+	return calendar.SyncResult{}, nil
+}
+
+func weekdayWindow(start, end string) map[time.Weekday][]Window {
+	hours := []Window{{Start: start, End: end}}
+	return map[time.Weekday][]Window{
+		time.Monday:    hours,
+		time.Tuesday:   hours,
+		time.Wednesday: hours,
+		time.Thursday:  hours,
+		time.Friday:    hours,
+	}
+}
+
+func TestFindAvailableSlots_SkipsBusyBlock(t *testing.T) {
+	loc, err := time.LoadLocation("UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Monday 2026-03-02, 9am-10am busy.
+	busyStart := time.Date(2026, 3, 2, 9, 0, 0, 0, loc)
+	client := &fakeClient{busy: []calendar.TimeBlock{{Start: busyStart, End: busyStart.Add(time.Hour)}}}
+	s := New(client)
+	s.now = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, loc) }
+
+	req := SlotRequest{
+		From:         time.Date(2026, 3, 2, 0, 0, 0, 0, loc),
+		To:           time.Date(2026, 3, 3, 0, 0, 0, 0, loc),
+		Duration:     30 * time.Minute,
+		Granularity:  30 * time.Minute,
+		WorkingHours: weekdayWindow("09:00", "11:00"),
+		Timezone:     "UTC",
+	}
+
+	slots, err := s.FindAvailableSlots(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, slot := range slots {
+		if slot.Start.Before(busyStart.Add(time.Hour)) && slot.Start.Add(req.Duration).After(busyStart) {
+			t.Errorf("slot %v overlaps busy block", slot)
+		}
+	}
+
+	// 09:00-10:00 busy, 10:00-11:00 free -> 2 half-hour slots expected
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 free slots, got %d: %+v", len(slots), slots)
+	}
+}
+
+func TestFindAvailableSlots_RespectsMinNotice(t *testing.T) {
+	loc := time.UTC
+	client := &fakeClient{}
+	s := New(client)
+	s.now = func() time.Time { return time.Date(2026, 3, 2, 8, 0, 0, 0, loc) }
+
+	req := SlotRequest{
+		From:         time.Date(2026, 3, 2, 0, 0, 0, 0, loc),
+		To:           time.Date(2026, 3, 3, 0, 0, 0, 0, loc),
+		Duration:     time.Hour,
+		Granularity:  time.Hour,
+		WorkingHours: weekdayWindow("09:00", "12:00"),
+		Timezone:     "UTC",
+		MinNotice:    2 * time.Hour,
+	}
+
+	slots, err := s.FindAvailableSlots(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cutoff := s.now().Add(req.MinNotice)
+	for _, slot := range slots {
+		if slot.Start.Before(cutoff) {
+			t.Errorf("slot %v starts before the minimum notice cutoff %v", slot, cutoff)
+		}
+	}
+}
+
+func TestFindAvailableSlots_CapsMaxBookingsPerDay(t *testing.T) {
+	loc := time.UTC
+	client := &fakeClient{}
+	s := New(client)
+	s.now = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, loc) }
+
+	req := SlotRequest{
+		From:              time.Date(2026, 3, 2, 0, 0, 0, 0, loc),
+		To:                time.Date(2026, 3, 3, 0, 0, 0, 0, loc),
+		Duration:          30 * time.Minute,
+		Granularity:       30 * time.Minute,
+		WorkingHours:      weekdayWindow("09:00", "12:00"),
+		Timezone:          "UTC",
+		MaxBookingsPerDay: 2,
+	}
+
+	slots, err := s.FindAvailableSlots(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 2 {
+		t.Fatalf("expected slots capped at 2, got %d", len(slots))
+	}
+}
@@ -0,0 +1,112 @@
+// Package subscriptions persists calendar.Subscription metadata in Redis so
+// webhook channels survive process restarts and can be renewed before expiry.
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"appointment-scheduling-bot/internal/calendar"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix = "calendar:subscription:"
+	indexKey  = "calendar:subscriptions:expiring"
+)
+
+// Store persists calendar.Subscription records in Redis
+type Store struct {
+	rdb *redis.Client
+}
+
+// NewRedisStore creates a Store backed by the given Redis connection URL
+func NewRedisStore(redisURL string) (*Store, error) {
+	opt, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	return &Store{rdb: redis.NewClient(opt)}, nil
+}
+
+// Save persists a subscription, keyed by its ChannelID, and indexes it by expiration
+func (s *Store) Save(ctx context.Context, sub calendar.Subscription) error {
+	data, err := json.Marshal(sub)
+	if err != nil {
+		return fmt.Errorf("failed to marshal subscription: %w", err)
+	}
+
+	ttl := time.Until(sub.Expiration)
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+
+	if err := s.rdb.Set(ctx, keyPrefix+sub.ChannelID, data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save subscription: %w", err)
+	}
+	if err := s.rdb.ZAdd(ctx, indexKey, redis.Z{
+		Score:  float64(sub.Expiration.Unix()),
+		Member: sub.ChannelID,
+	}).Err(); err != nil {
+		return fmt.Errorf("failed to index subscription expiration: %w", err)
+	}
+
+	return nil
+}
+
+// Get loads a subscription by channel ID
+func (s *Store) Get(ctx context.Context, channelID string) (calendar.Subscription, error) {
+	data, err := s.rdb.Get(ctx, keyPrefix+channelID).Bytes()
+	if err == redis.Nil {
+		return calendar.Subscription{}, fmt.Errorf("subscription %q not found", channelID)
+	}
+	if err != nil {
+		return calendar.Subscription{}, fmt.Errorf("failed to load subscription: %w", err)
+	}
+
+	var sub calendar.Subscription
+	if err := json.Unmarshal(data, &sub); err != nil {
+		return calendar.Subscription{}, fmt.Errorf("failed to unmarshal subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// Delete removes a subscription
+func (s *Store) Delete(ctx context.Context, channelID string) error {
+	if err := s.rdb.Del(ctx, keyPrefix+channelID).Err(); err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+	if err := s.rdb.ZRem(ctx, indexKey, channelID).Err(); err != nil {
+		return fmt.Errorf("failed to remove subscription from expiration index: %w", err)
+	}
+	return nil
+}
+
+// ExpiringBefore returns every subscription whose expiration is before cutoff,
+// used by the background renewer to find channels that need refreshing.
+func (s *Store) ExpiringBefore(ctx context.Context, cutoff time.Time) ([]calendar.Subscription, error) {
+	channelIDs, err := s.rdb.ZRangeByScore(ctx, indexKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(cutoff.Unix(), 10),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expiration index: %w", err)
+	}
+
+	var subs []calendar.Subscription
+	for _, channelID := range channelIDs {
+		sub, err := s.Get(ctx, channelID)
+		if err != nil {
+			// The subscription key itself expired via its own TTL; drop it from the index too.
+			_ = s.rdb.ZRem(ctx, indexKey, channelID).Err()
+			continue
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
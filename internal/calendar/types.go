@@ -1,6 +1,9 @@
 package calendar
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // TimeBlock represents a busy time block from Google Calendar
 type TimeBlock struct {
@@ -11,27 +14,80 @@ type TimeBlock struct {
 
 // Appointment represents a calendar appointment/event
 type Appointment struct {
-	Summary       string    `json:"summary"`
-	Description   string    `json:"description"`
-	Start         time.Time `json:"start"`
-	End           time.Time `json:"end"`
-	AttendeeName  string    `json:"attendee_name"`
-	AttendeeEmail string    `json:"attendee_email"`
-	Location      string    `json:"location"`
-	Timezone      string    `json:"timezone"`
+	Summary       string      `json:"summary"`
+	Description   string      `json:"description"`
+	Start         time.Time   `json:"start"`
+	End           time.Time   `json:"end"`
+	AttendeeName  string      `json:"attendee_name"`
+	AttendeeEmail string      `json:"attendee_email"`
+	Location      string      `json:"location"`
+	Timezone      string      `json:"timezone"`
+	Recurrence    *Recurrence `json:"recurrence,omitempty"`
+	// CalendarID optionally overrides the backend's primary calendar for
+	// CreateEvent/UpdateEvent. Empty means "use the primary". DeleteEvent takes
+	// no Appointment and always targets the primary calendar.
+	CalendarID string `json:"calendar_id,omitempty"`
+}
+
+// CalendarInfo describes a single calendar a backend can read from or write to
+type CalendarInfo struct {
+	ID         string `json:"id"`
+	Summary    string `json:"summary"`
+	Timezone   string `json:"timezone"`
+	AccessRole string `json:"access_role"`
+}
+
+// Recurrence describes an RFC 5545 recurrence rule plus any exceptions
+type Recurrence struct {
+	// RRule is a raw RFC 5545 RRULE value, e.g. "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20261231T000000Z"
+	RRule string `json:"rrule,omitempty"`
+	// ExDates are occurrence start times to exclude (cancelled instances)
+	ExDates []time.Time `json:"exdates,omitempty"`
+	// RDates are additional one-off occurrence start times beyond what RRule generates
+	RDates []time.Time `json:"rdates,omitempty"`
+}
+
+// Subscription represents a push-notification channel subscribed against a calendar
+type Subscription struct {
+	ChannelID  string    `json:"channel_id"`
+	ResourceID string    `json:"resource_id"`
+	Token      string    `json:"token"`
+	Expiration time.Time `json:"expiration"`
+	SyncToken  string    `json:"sync_token,omitempty"`
+}
+
+// SyncResult is the outcome of an IncrementalSync call
+type SyncResult struct {
+	// Changed holds the busy blocks affected since the sync token was issued
+	Changed []TimeBlock `json:"changed"`
+	// NextSyncToken should be persisted and passed to the next IncrementalSync call
+	NextSyncToken string `json:"next_sync_token"`
 }
 
 // Client interface for Google Calendar operations
 type Client interface {
 	// ListBusy returns all busy time blocks between from and to
 	ListBusy(from, to time.Time) ([]TimeBlock, error)
-	
+
 	// CreateEvent creates a new calendar event and returns the event ID
 	CreateEvent(appt Appointment) (eventID string, err error)
-	
+
 	// UpdateEvent updates an existing calendar event
 	UpdateEvent(eventID string, appt Appointment) error
-	
+
 	// DeleteEvent deletes a calendar event
 	DeleteEvent(eventID string) error
+
+	// ListCalendars returns the calendars this backend can see
+	ListCalendars() ([]CalendarInfo, error)
+
+	// Subscribe registers a push-notification channel for calendar changes
+	Subscribe(ctx context.Context, channelID, callbackURL string, ttl time.Duration) (Subscription, error)
+
+	// Unsubscribe tears down a previously registered push-notification channel
+	Unsubscribe(ctx context.Context, sub Subscription) error
+
+	// IncrementalSync returns what changed since syncToken was issued, along with
+	// the next token to use. Pass an empty syncToken to perform an initial sync.
+	IncrementalSync(ctx context.Context, syncToken string) (SyncResult, error)
 } 
\ No newline at end of file
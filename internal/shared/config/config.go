@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -13,14 +14,27 @@ type Config struct {
 	Env           string
 	HttpPort      string
 	Timezone      string
+	// Calendar backend selector ("google", "caldav" or "memory")
+	CalendarBackend string
 	// Google
 	GCalCalendarID string
+	// GCalCalendarIDs lists every calendar ListBusy should aggregate across.
+	// Defaults to just GCalCalendarID when GCAL_CALENDAR_IDS is unset.
+	GCalCalendarIDs []string
 	GoogleCredsJSON string // base64 or path
+	// CalDAV
+	CaldavURI      string
+	CaldavUsername string
+	CaldavPassword string
+	// Memory (in-memory fake backend, for tests and local development)
+	MemoryCalendarSeed string
 	// Supabase
 	SupabaseURL string
 	SupabaseKey string
 	// Redis
 	RedisURL string
+	// Webhooks
+	WebhookCallbackURL string
 }
 
 // Load reads configuration from environment variables
@@ -34,23 +48,40 @@ func Load() (Config, error) {
 	}
 
 	config := Config{
-		Env:           getEnv("APP_ENV", "development"),
-		HttpPort:      getEnv("HTTP_PORT", "8080"),
-		Timezone:      getEnv("TZ", "UTC"),
-		GCalCalendarID: getEnv("GCAL_CALENDAR_ID", ""),
+		Env:             getEnv("APP_ENV", "development"),
+		HttpPort:        getEnv("HTTP_PORT", "8080"),
+		Timezone:        getEnv("TZ", "UTC"),
+		CalendarBackend: getEnv("CALENDAR_BACKEND", "google"),
+		GCalCalendarID:  getEnv("GCAL_CALENDAR_ID", ""),
+		GCalCalendarIDs: splitAndTrim(getEnv("GCAL_CALENDAR_IDS", "")),
 		GoogleCredsJSON: getEnv("GOOGLE_CREDS_JSON", ""),
-		SupabaseURL:   getEnv("SUPABASE_URL", ""),
-		SupabaseKey:   getEnv("SUPABASE_KEY", ""),
-		RedisURL:      getEnv("REDIS_URL", "redis://localhost:6379"),
+		CaldavURI:       getEnv("CALDAV_URI", ""),
+		CaldavUsername:  getEnv("CALDAV_USERNAME", ""),
+		CaldavPassword:  getEnv("CALDAV_PASSWORD", ""),
+		MemoryCalendarSeed: getEnv("MEMORY_CALENDAR_SEED", ""),
+		SupabaseURL:     getEnv("SUPABASE_URL", ""),
+		SupabaseKey:     getEnv("SUPABASE_KEY", ""),
+		RedisURL:        getEnv("REDIS_URL", "redis://localhost:6379"),
+		WebhookCallbackURL: getEnv("GCAL_WEBHOOK_CALLBACK_URL", ""),
 	}
 
 	// Validate required fields only in production
 	if config.Env == "production" {
-		if config.GCalCalendarID == "" {
-			return config, fmt.Errorf("GCAL_CALENDAR_ID is required in production")
-		}
-		if config.GoogleCredsJSON == "" {
-			return config, fmt.Errorf("GOOGLE_CREDS_JSON is required in production")
+		switch config.CalendarBackend {
+		case "caldav":
+			if config.CaldavURI == "" {
+				return config, fmt.Errorf("CALDAV_URI is required in production")
+			}
+			if config.CaldavUsername == "" || config.CaldavPassword == "" {
+				return config, fmt.Errorf("CALDAV_USERNAME and CALDAV_PASSWORD are required in production")
+			}
+		default:
+			if config.GCalCalendarID == "" {
+				return config, fmt.Errorf("GCAL_CALENDAR_ID is required in production")
+			}
+			if config.GoogleCredsJSON == "" {
+				return config, fmt.Errorf("GOOGLE_CREDS_JSON is required in production")
+			}
 		}
 	}
 
@@ -65,6 +96,20 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// splitAndTrim splits a comma-separated value into a trimmed, non-empty slice
+func splitAndTrim(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var parts []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
 // GetGoogleCreds returns the Google credentials as bytes
 func (c *Config) GetGoogleCreds() ([]byte, error) {
 	// Check if it's base64 encoded